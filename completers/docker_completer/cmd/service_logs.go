@@ -3,6 +3,8 @@ package cmd
 import (
 	"github.com/rsteube/carapace"
 	"github.com/rsteube/carapace-bin/pkg/actions/docker"
+	"github.com/rsteube/carapace-bin/pkg/actions/tmpl"
+	"github.com/rsteube/carapace-bin/pkg/actions/tmpl/schemas"
 	"github.com/spf13/cobra"
 )
 
@@ -17,6 +19,7 @@ func init() {
 
 	service_logsCmd.Flags().Bool("details", false, "Show extra details provided to logs")
 	service_logsCmd.Flags().BoolP("follow", "f", false, "Follow log output")
+	service_logsCmd.Flags().String("format", "", "Format the output using the given Go template")
 	service_logsCmd.Flags().Bool("no-resolve", false, "Do not map IDs to Names in output")
 	service_logsCmd.Flags().Bool("no-task-ids", false, "Do not include task IDs in output")
 	service_logsCmd.Flags().Bool("no-trunc", false, "Do not truncate output")
@@ -29,4 +32,8 @@ func init() {
 	carapace.Gen(service_logsCmd).PositionalCompletion(
 		docker.ActionServices(),
 	)
+
+	carapace.Gen(service_logsCmd).FlagCompletion(carapace.ActionMap{
+		"format": tmpl.ActionGoTemplate(schemas.DockerServiceLogEntry{}),
+	})
 }
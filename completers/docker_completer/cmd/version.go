@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/tmpl"
+	"github.com/rsteube/carapace-bin/pkg/actions/tmpl/schemas"
 	"github.com/spf13/cobra"
 )
 
@@ -15,4 +17,8 @@ func init() {
 	carapace.Gen(versionCmd).Standalone()
 	versionCmd.Flags().StringP("format", "f", "", "Format the output using the given Go template")
 	rootCmd.AddCommand(versionCmd)
+
+	carapace.Gen(versionCmd).FlagCompletion(carapace.ActionMap{
+		"format": tmpl.ActionGoTemplate(schemas.DockerVersion{}),
+	})
 }
@@ -1,6 +1,9 @@
 package cmd
 
 import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/tmpl"
+	"github.com/rsteube/carapace-bin/pkg/actions/tmpl/schemas"
 	"github.com/spf13/cobra"
 )
 
@@ -13,4 +16,8 @@ var cache_listCmd = &cobra.Command{
 func init() {
 	cache_listCmd.Flags().String("format", "{{.CacheImage}}", "Go template format string for the cache list output.")
 	cacheCmd.AddCommand(cache_listCmd)
+
+	carapace.Gen(cache_listCmd).FlagCompletion(carapace.ActionMap{
+		"format": tmpl.ActionGoTemplate(schemas.MinikubeCacheListImage{}),
+	})
 }
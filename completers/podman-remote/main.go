@@ -0,0 +1,11 @@
+// command podman-remote is a drop-in completer alias for users who invoke
+// podman's remote-only client binary instead of podman itself.
+package main
+
+import (
+	"github.com/rsteube/carapace-bin/completers/podman_completer/cmd"
+)
+
+func main() {
+	cmd.Execute()
+}
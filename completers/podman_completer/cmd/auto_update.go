@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+)
+
+var auto_updateCmd = &cobra.Command{
+	Use:   "auto-update",
+	Short: "Auto update containers according to their auto-update policy",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(auto_updateCmd).Standalone()
+
+	auto_updateCmd.Flags().Bool("dry-run", false, "Check for pending updates")
+	auto_updateCmd.Flags().String("format", "", "Format the output using the given Go template")
+	rootCmd.AddCommand(auto_updateCmd)
+}
@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var container_execCmd = &cobra.Command{
+	Use:   "exec",
+	Short: "Run a process in a running container",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(container_execCmd).Standalone()
+
+	container_execCmd.Flags().BoolP("detach", "d", false, "Run the exec session in detached mode")
+	container_execCmd.Flags().BoolP("interactive", "i", false, "Keep STDIN open even if not attached")
+	container_execCmd.Flags().BoolP("tty", "t", false, "Allocate a pseudo-TTY")
+	container_execCmd.Flags().StringP("user", "u", "", "Sets the username or UID used and optionally the groupname or GID for the specified command")
+	container_execCmd.Flags().StringP("workdir", "w", "", "Working directory inside the container")
+	containerCmd.AddCommand(container_execCmd)
+
+	carapace.Gen(container_execCmd).PositionalCompletion(
+		podman.ActionContainers(),
+	)
+}
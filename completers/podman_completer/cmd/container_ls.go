@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+)
+
+var container_lsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"ps", "list"},
+	Short:   "List containers",
+	Run:     func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(container_lsCmd).Standalone()
+
+	container_lsCmd.Flags().BoolP("all", "a", false, "Show all the containers, default is only running containers")
+	container_lsCmd.Flags().StringP("filter", "f", "", "Filter output based on conditions given")
+	container_lsCmd.Flags().StringP("format", "", "", "Format the output using the given Go template")
+	container_lsCmd.Flags().Int("last", -1, "Print the n last created containers (all states)")
+	container_lsCmd.Flags().Bool("noheading", false, "Do not print headers")
+	container_lsCmd.Flags().BoolP("quiet", "q", false, "Print the numeric IDs of the containers only")
+	container_lsCmd.Flags().Bool("sync", false, "Sync container state with OCI runtime")
+	containerCmd.AddCommand(container_lsCmd)
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var container_rmCmd = &cobra.Command{
+	Use:   "rm",
+	Short: "Remove one or more containers",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(container_rmCmd).Standalone()
+
+	container_rmCmd.Flags().Bool("all", false, "Remove all containers")
+	container_rmCmd.Flags().BoolP("force", "f", false, "Force removal of a running or unusable container")
+	container_rmCmd.Flags().BoolP("volumes", "v", false, "Remove anonymous volumes associated with the container")
+	containerCmd.AddCommand(container_rmCmd)
+
+	carapace.Gen(container_rmCmd).PositionalAnyCompletion(
+		podman.ActionContainers(),
+	)
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/os"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var container_runCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Run a command in a new container",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(container_runCmd).Standalone()
+
+	container_runCmd.Flags().StringSlice("cap-add", nil, "Add Linux capabilities")
+	container_runCmd.Flags().StringSlice("cap-drop", nil, "Drop Linux capabilities")
+	container_runCmd.Flags().StringSlice("security-opt", nil, "Security options")
+	container_runCmd.Flags().String("cgroupns", "", "cgroup namespace to use")
+	container_runCmd.Flags().String("uts", "", "UTS namespace to use")
+	container_runCmd.Flags().String("ipc", "", "IPC namespace to use")
+	container_runCmd.Flags().String("pid", "", "PID namespace to use")
+	container_runCmd.Flags().String("userns", "", "User namespace to use")
+	container_runCmd.Flags().String("network", "", "Connect a container to a network")
+	containerCmd.AddCommand(container_runCmd)
+
+	carapace.Gen(container_runCmd).FlagCompletion(carapace.ActionMap{
+		"cap-add":      os.ActionLinuxCapabilities(),
+		"cap-drop":     os.ActionLinuxCapabilities(),
+		"security-opt": securityOptAction(),
+		"cgroupns":     podman.ActionNamespaceMode(),
+		"uts":          podman.ActionNamespaceMode(),
+		"ipc":          podman.ActionNamespaceMode(),
+		"pid":          podman.ActionNamespaceMode(),
+		"userns":       podman.ActionUserNamespaceMode(),
+		"network":      podman.ActionNetworks(),
+	})
+
+	carapace.Gen(container_runCmd).PositionalCompletion(
+		podman.ActionImages(),
+	)
+}
+
+// securityOptAction completes the `key=value` pairs accepted by
+// `--security-opt`, resolving `seccomp=` profile paths and `apparmor=`
+// profile names.
+func securityOptAction() carapace.Action {
+	return carapace.ActionMultiParts("=", func(args []string, parts []string) carapace.Action {
+		switch len(parts) {
+		case 0:
+			return carapace.ActionValues("apparmor", "label", "no-new-privileges", "seccomp").Suffix("=").ToA()
+		case 1:
+			switch parts[0] {
+			case "seccomp":
+				return os.ActionSeccompProfiles()
+			case "apparmor":
+				return os.ActionAppArmorProfiles()
+			default:
+				return carapace.ActionValues()
+			}
+		default:
+			return carapace.ActionValues()
+		}
+	})
+}
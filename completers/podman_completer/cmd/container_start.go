@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var container_startCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start one or more containers",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(container_startCmd).Standalone()
+
+	container_startCmd.Flags().Bool("all", false, "Start all containers regardless of their state or configuration")
+	container_startCmd.Flags().BoolP("attach", "a", false, "Attach container's STDOUT and STDERR")
+	container_startCmd.Flags().BoolP("interactive", "i", false, "Keep STDIN open even if not attached")
+	containerCmd.AddCommand(container_startCmd)
+
+	carapace.Gen(container_startCmd).PositionalAnyCompletion(
+		podman.ActionContainers(),
+	)
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var container_stopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop one or more containers",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(container_stopCmd).Standalone()
+
+	container_stopCmd.Flags().Bool("all", false, "Stop all running containers")
+	container_stopCmd.Flags().Bool("ignore", false, "Ignore errors when a specified container is missing")
+	container_stopCmd.Flags().Uint("time", 10, "Seconds to wait for stop before killing the container")
+	containerCmd.AddCommand(container_stopCmd)
+
+	carapace.Gen(container_stopCmd).PositionalAnyCompletion(
+		podman.ActionContainers(),
+	)
+}
@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+)
+
+var image_lsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"images", "list"},
+	Short:   "List images in local storage",
+	Run:     func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(image_lsCmd).Standalone()
+
+	image_lsCmd.Flags().BoolP("all", "a", false, "Show all images, including intermediate images from a build")
+	image_lsCmd.Flags().StringP("filter", "f", "", "Filter output based on conditions given")
+	image_lsCmd.Flags().String("format", "", "Format the output using the given Go template")
+	image_lsCmd.Flags().Bool("noheading", false, "Do not print column headings")
+	image_lsCmd.Flags().BoolP("quiet", "q", false, "Display only image IDs")
+	imageCmd.AddCommand(image_lsCmd)
+}
@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+)
+
+var image_pullCmd = &cobra.Command{
+	Use:   "pull",
+	Short: "Pull an image from a registry",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(image_pullCmd).Standalone()
+
+	image_pullCmd.Flags().StringP("arch", "", "", "Use ARCH instead of the architecture of the machine for choosing images")
+	image_pullCmd.Flags().Bool("disable-content-trust", false, "This is a Docker specific option and is a NOOP")
+	image_pullCmd.Flags().BoolP("quiet", "q", false, "Suppress output information when pulling images")
+	image_pullCmd.Flags().String("tls-verify", "", "Require HTTPS and verify certificates when contacting registries")
+	imageCmd.AddCommand(image_pullCmd)
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var image_rmCmd = &cobra.Command{
+	Use:     "rm",
+	Aliases: []string{"rmi"},
+	Short:   "Remove one or more images from local storage",
+	Run:     func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(image_rmCmd).Standalone()
+
+	image_rmCmd.Flags().BoolP("all", "a", false, "Remove all images")
+	image_rmCmd.Flags().BoolP("force", "f", false, "Force removal of the image")
+	imageCmd.AddCommand(image_rmCmd)
+
+	carapace.Gen(image_rmCmd).PositionalAnyCompletion(
+		podman.ActionImages(),
+	)
+}
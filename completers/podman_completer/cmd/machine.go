@@ -0,0 +1,17 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+)
+
+var machineCmd = &cobra.Command{
+	Use:   "machine",
+	Short: "Manage a virtual machine",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(machineCmd).Standalone()
+	rootCmd.AddCommand(machineCmd)
+}
@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var machine_sshCmd = &cobra.Command{
+	Use:   "ssh",
+	Short: "SSH into an existing machine",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(machine_sshCmd).Standalone()
+
+	machine_sshCmd.Flags().StringP("username", "u", "", "Username to use when ssh-ing into the VM")
+	machineCmd.AddCommand(machine_sshCmd)
+
+	carapace.Gen(machine_sshCmd).PositionalCompletion(
+		podman.ActionMachines(),
+	)
+}
@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var machine_startCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start an existing machine",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(machine_startCmd).Standalone()
+	machineCmd.AddCommand(machine_startCmd)
+
+	carapace.Gen(machine_startCmd).PositionalCompletion(
+		podman.ActionMachines(),
+	)
+}
@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var machine_stopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop an existing machine",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(machine_stopCmd).Standalone()
+	machineCmd.AddCommand(machine_stopCmd)
+
+	carapace.Gen(machine_stopCmd).PositionalCompletion(
+		podman.ActionMachines(),
+	)
+}
@@ -0,0 +1,17 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+)
+
+var manifestCmd = &cobra.Command{
+	Use:   "manifest",
+	Short: "Manipulate manifest lists and image indexes",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(manifestCmd).Standalone()
+	rootCmd.AddCommand(manifestCmd)
+}
@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+)
+
+var network_lsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List networks",
+	Run:     func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(network_lsCmd).Standalone()
+
+	network_lsCmd.Flags().StringP("filter", "f", "", "Provide filter values")
+	network_lsCmd.Flags().String("format", "", "Pretty-print networks to JSON or using a Go template")
+	network_lsCmd.Flags().BoolP("quiet", "q", false, "Display only network names")
+	networkCmd.AddCommand(network_lsCmd)
+}
@@ -0,0 +1,17 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+)
+
+var playCmd = &cobra.Command{
+	Use:   "play",
+	Short: "Play a pod",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(playCmd).Standalone()
+	rootCmd.AddCommand(playCmd)
+}
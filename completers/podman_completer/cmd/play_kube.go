@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var play_kubeCmd = &cobra.Command{
+	Use:   "kube",
+	Short: "Create containers, pods and volumes based on Kubernetes YAML",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(play_kubeCmd).Standalone()
+
+	play_kubeCmd.Flags().String("network", "", "Connect pod to a network")
+	play_kubeCmd.Flags().String("tls-verify", "", "Require HTTPS and verify certificates when contacting registries")
+	playCmd.AddCommand(play_kubeCmd)
+
+	carapace.Gen(play_kubeCmd).PositionalCompletion(
+		podman.ActionKubeYamls(),
+	)
+}
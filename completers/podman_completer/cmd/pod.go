@@ -0,0 +1,17 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+)
+
+var podCmd = &cobra.Command{
+	Use:   "pod",
+	Short: "Manage pods",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(podCmd).Standalone()
+	rootCmd.AddCommand(podCmd)
+}
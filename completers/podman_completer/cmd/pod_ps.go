@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+)
+
+var pod_psCmd = &cobra.Command{
+	Use:     "ps",
+	Aliases: []string{"ls", "list"},
+	Short:   "List pods",
+	Run:     func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(pod_psCmd).Standalone()
+
+	pod_psCmd.Flags().BoolP("all", "a", false, "List all pods")
+	pod_psCmd.Flags().StringP("filter", "f", "", "Filter output based on conditions given")
+	pod_psCmd.Flags().String("format", "", "Format the output using the given Go template")
+	pod_psCmd.Flags().BoolP("quiet", "q", false, "Print the numeric IDs of the pods only")
+	podCmd.AddCommand(pod_psCmd)
+}
@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var pod_rmCmd = &cobra.Command{
+	Use:   "rm",
+	Short: "Remove one or more pods",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(pod_rmCmd).Standalone()
+
+	pod_rmCmd.Flags().Bool("all", false, "Remove all pods")
+	pod_rmCmd.Flags().BoolP("force", "f", false, "Force removal of a running pod by first stopping all containers")
+	podCmd.AddCommand(pod_rmCmd)
+
+	carapace.Gen(pod_rmCmd).PositionalAnyCompletion(
+		podman.ActionPods(),
+	)
+}
@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var pod_startCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start one or more pods",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(pod_startCmd).Standalone()
+
+	pod_startCmd.Flags().Bool("all", false, "Start all pods")
+	podCmd.AddCommand(pod_startCmd)
+
+	carapace.Gen(pod_startCmd).PositionalAnyCompletion(
+		podman.ActionPods(),
+	)
+}
@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var pod_stopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop one or more pods",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(pod_stopCmd).Standalone()
+
+	pod_stopCmd.Flags().Bool("all", false, "Stop all running pods")
+	pod_stopCmd.Flags().Uint("time", 10, "Seconds to wait for pod stop before killing the container")
+	podCmd.AddCommand(pod_stopCmd)
+
+	carapace.Gen(pod_stopCmd).PositionalAnyCompletion(
+		podman.ActionPods(),
+	)
+}
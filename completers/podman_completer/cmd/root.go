@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "podman",
+	Short: "Manage pods, containers and images",
+}
+
+func init() {
+	carapace.Gen(rootCmd)
+
+	rootCmd.PersistentFlags().String("connection", "", "Connection to use for remote podman")
+	rootCmd.PersistentFlags().Bool("remote", false, "Access remote Podman service")
+	rootCmd.PersistentFlags().String("url", "", "URL to access Podman service")
+
+	carapace.Gen(rootCmd).FlagCompletion(carapace.ActionMap{
+		"connection": podman.ActionConnections(),
+	})
+}
+
+// Execute executes the root command.
+func Execute() error {
+	return rootCmd.Execute()
+}
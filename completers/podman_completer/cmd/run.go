@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/os"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var runCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Run a command in a new container",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(runCmd).Standalone()
+
+	runCmd.Flags().StringSlice("cap-add", nil, "Add Linux capabilities")
+	runCmd.Flags().StringSlice("cap-drop", nil, "Drop Linux capabilities")
+	runCmd.Flags().StringSlice("security-opt", nil, "Security options")
+	runCmd.Flags().String("cgroupns", "", "cgroup namespace to use")
+	runCmd.Flags().String("uts", "", "UTS namespace to use")
+	runCmd.Flags().String("ipc", "", "IPC namespace to use")
+	runCmd.Flags().String("pid", "", "PID namespace to use")
+	runCmd.Flags().String("userns", "", "User namespace to use")
+	runCmd.Flags().String("network", "", "Connect a container to a network")
+	rootCmd.AddCommand(runCmd)
+
+	carapace.Gen(runCmd).FlagCompletion(carapace.ActionMap{
+		"cap-add":      os.ActionLinuxCapabilities(),
+		"cap-drop":     os.ActionLinuxCapabilities(),
+		"security-opt": securityOptAction(),
+		"cgroupns":     podman.ActionNamespaceMode(),
+		"uts":          podman.ActionNamespaceMode(),
+		"ipc":          podman.ActionNamespaceMode(),
+		"pid":          podman.ActionNamespaceMode(),
+		"userns":       podman.ActionUserNamespaceMode(),
+		"network":      podman.ActionNetworks(),
+	})
+
+	carapace.Gen(runCmd).PositionalCompletion(
+		podman.ActionImages(),
+	)
+}
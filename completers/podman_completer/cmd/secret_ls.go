@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+)
+
+var secret_lsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List secrets",
+	Run:     func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(secret_lsCmd).Standalone()
+
+	secret_lsCmd.Flags().String("format", "", "Format volume output using Go template")
+	secret_lsCmd.Flags().BoolP("quiet", "q", false, "Print secret output in quiet mode")
+	secretCmd.AddCommand(secret_lsCmd)
+}
@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var secret_rmCmd = &cobra.Command{
+	Use:   "rm",
+	Short: "Remove one or more secrets",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(secret_rmCmd).Standalone()
+
+	secret_rmCmd.Flags().Bool("all", false, "Remove all secrets")
+	secretCmd.AddCommand(secret_rmCmd)
+
+	carapace.Gen(secret_rmCmd).PositionalAnyCompletion(
+		podman.ActionSecrets(),
+	)
+}
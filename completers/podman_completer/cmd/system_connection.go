@@ -0,0 +1,17 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+)
+
+var system_connectionCmd = &cobra.Command{
+	Use:   "connection",
+	Short: "Manage the destination(s) for Podman service",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(system_connectionCmd).Standalone()
+	systemCmd.AddCommand(system_connectionCmd)
+}
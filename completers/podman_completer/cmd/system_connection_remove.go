@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var system_connection_removeCmd = &cobra.Command{
+	Use:     "remove",
+	Aliases: []string{"rm"},
+	Short:   "Delete named destination",
+	Run:     func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(system_connection_removeCmd).Standalone()
+
+	system_connection_removeCmd.Flags().Bool("all", false, "Remove all connections")
+	system_connectionCmd.AddCommand(system_connection_removeCmd)
+
+	carapace.Gen(system_connection_removeCmd).PositionalAnyCompletion(
+		podman.ActionConnections(),
+	)
+}
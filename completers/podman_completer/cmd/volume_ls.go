@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+)
+
+var volume_lsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List volumes",
+	Run:     func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(volume_lsCmd).Standalone()
+
+	volume_lsCmd.Flags().StringP("filter", "f", "", "Filter volumes based on given conditions")
+	volume_lsCmd.Flags().String("format", "", "Format volume output using Go template")
+	volume_lsCmd.Flags().BoolP("quiet", "q", false, "Print volume output in quiet mode")
+	volumeCmd.AddCommand(volume_lsCmd)
+}
@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/podman"
+	"github.com/spf13/cobra"
+)
+
+var volume_rmCmd = &cobra.Command{
+	Use:   "rm",
+	Short: "Remove one or more volumes",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(volume_rmCmd).Standalone()
+
+	volume_rmCmd.Flags().Bool("all", false, "Remove all volumes")
+	volume_rmCmd.Flags().BoolP("force", "f", false, "Remove a volume in use")
+	volumeCmd.AddCommand(volume_rmCmd)
+
+	carapace.Gen(volume_rmCmd).PositionalAnyCompletion(
+		podman.ActionVolumes(),
+	)
+}
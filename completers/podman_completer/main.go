@@ -0,0 +1,9 @@
+package main
+
+import (
+	"github.com/rsteube/carapace-bin/completers/podman_completer/cmd"
+)
+
+func main() {
+	cmd.Execute()
+}
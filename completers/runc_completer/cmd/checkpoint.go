@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/runc"
+	"github.com/spf13/cobra"
+)
+
+var checkpointCmd = &cobra.Command{
+	Use:   "checkpoint",
+	Short: "Checkpoint a running container",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(checkpointCmd).Standalone()
+
+	checkpointCmd.Flags().String("image-path", "", "Path for saving criu image files")
+	checkpointCmd.Flags().String("work-path", "", "Path for saving work files and logs")
+	checkpointCmd.Flags().Bool("leave-running", false, "Leave the process running after checkpointing")
+	checkpointCmd.Flags().Bool("tcp-established", false, "Allow open tcp connections")
+	rootCmd.AddCommand(checkpointCmd)
+
+	carapace.Gen(checkpointCmd).PositionalCompletion(
+		runc.ActionContainers(),
+	)
+}
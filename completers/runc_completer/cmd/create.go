@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/runc"
+	"github.com/spf13/cobra"
+)
+
+var createCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a container",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(createCmd).Standalone()
+
+	createCmd.Flags().StringP("bundle", "b", "", "Path to the root of the bundle directory")
+	createCmd.Flags().String("console-socket", "", "Path to an AF_UNIX socket which will receive a file descriptor referencing the master end of the console's pseudoterminal")
+	createCmd.Flags().String("pid-file", "", "Specify the file to write the process id to")
+	rootCmd.AddCommand(createCmd)
+
+	carapace.Gen(createCmd).FlagCompletion(carapace.ActionMap{
+		"bundle": runc.ActionOCIBundles(),
+	})
+}
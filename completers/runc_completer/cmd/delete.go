@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/runc"
+	"github.com/spf13/cobra"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete any resources held by the container often used with detached container",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(deleteCmd).Standalone()
+
+	deleteCmd.Flags().BoolP("force", "f", false, "Forcibly deletes the container if it is still running")
+	rootCmd.AddCommand(deleteCmd)
+
+	carapace.Gen(deleteCmd).PositionalAnyCompletion(
+		runc.ActionContainers(),
+	)
+}
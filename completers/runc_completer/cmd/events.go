@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/runc"
+	"github.com/spf13/cobra"
+)
+
+var eventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "Display container events such as OOM notifications, cpu, memory, and IO usage statistics",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(eventsCmd).Standalone()
+
+	eventsCmd.Flags().String("interval", "5s", "Set the stats collection interval")
+	eventsCmd.Flags().Bool("stats", false, "Display the container's stats then exit")
+	rootCmd.AddCommand(eventsCmd)
+
+	carapace.Gen(eventsCmd).PositionalCompletion(
+		runc.ActionContainers(),
+	)
+}
@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/os"
+	"github.com/rsteube/carapace-bin/pkg/actions/runc"
+	"github.com/spf13/cobra"
+)
+
+var execCmd = &cobra.Command{
+	Use:   "exec",
+	Short: "Execute new process inside the container",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(execCmd).Standalone()
+
+	execCmd.Flags().BoolP("detach", "d", false, "Detach from the container's process")
+	execCmd.Flags().BoolP("tty", "t", false, "Allocate a pseudo-TTY")
+	execCmd.Flags().StringP("user", "u", "", "UID (format: <uid>[:<gid>])")
+	rootCmd.AddCommand(execCmd)
+
+	carapace.Gen(execCmd).PositionalCompletion(
+		runc.ActionContainers(),
+		os.ActionProcessExecutables(),
+	)
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/os"
+	"github.com/rsteube/carapace-bin/pkg/actions/runc"
+	"github.com/spf13/cobra"
+)
+
+var killCmd = &cobra.Command{
+	Use:   "kill",
+	Short: "Kill sends the specified signal (default: SIGTERM) to the container's init process",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(killCmd).Standalone()
+
+	killCmd.Flags().Bool("all", false, "Send the specified signal to all processes inside the container")
+	rootCmd.AddCommand(killCmd)
+
+	carapace.Gen(killCmd).PositionalCompletion(
+		runc.ActionContainers(),
+		os.ActionKillSignals(),
+	)
+}
@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+)
+
+var listCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ps"},
+	Short:   "Lists containers started by runc with the given root",
+	Run:     func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(listCmd).Standalone()
+
+	listCmd.Flags().String("format", "table", "Select the output format: table or json")
+	listCmd.Flags().BoolP("quiet", "q", false, "Display only container IDs")
+	rootCmd.AddCommand(listCmd)
+
+	carapace.Gen(listCmd).FlagCompletion(carapace.ActionMap{
+		"format": carapace.ActionValues("table", "json"),
+	})
+}
@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/runc"
+	"github.com/spf13/cobra"
+)
+
+var pauseCmd = &cobra.Command{
+	Use:   "pause",
+	Short: "Pause suspends all processes inside the container",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(pauseCmd).Standalone()
+	rootCmd.AddCommand(pauseCmd)
+
+	carapace.Gen(pauseCmd).PositionalCompletion(
+		runc.ActionContainers(),
+	)
+}
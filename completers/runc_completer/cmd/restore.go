@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/runc"
+	"github.com/spf13/cobra"
+)
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore a container from a previous checkpoint",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(restoreCmd).Standalone()
+
+	restoreCmd.Flags().String("image-path", "", "Path to criu image files for restoring")
+	restoreCmd.Flags().StringP("bundle", "b", "", "Path to the root of the bundle directory")
+	restoreCmd.Flags().Bool("detach", false, "Detach from the container's process")
+	rootCmd.AddCommand(restoreCmd)
+
+	carapace.Gen(restoreCmd).FlagCompletion(carapace.ActionMap{
+		"image-path": runc.ActionCheckpoints(),
+		"bundle":     runc.ActionOCIBundles(),
+	})
+
+	carapace.Gen(restoreCmd).PositionalCompletion(
+		runc.ActionContainers(),
+	)
+}
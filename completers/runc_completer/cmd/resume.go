@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/runc"
+	"github.com/spf13/cobra"
+)
+
+var resumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resumes all processes that have been previously paused",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(resumeCmd).Standalone()
+	rootCmd.AddCommand(resumeCmd)
+
+	carapace.Gen(resumeCmd).PositionalCompletion(
+		runc.ActionContainers(),
+	)
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/os"
+	"github.com/spf13/cobra"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "runc",
+	Short: "Open Container Initiative runtime",
+}
+
+func init() {
+	carapace.Gen(rootCmd)
+
+	rootCmd.PersistentFlags().String("root", "", "Root directory for storage of container state")
+	rootCmd.PersistentFlags().String("log-format", "text", "Set the log format ('text' (default), or 'json')")
+	rootCmd.PersistentFlags().Bool("systemd-cgroup", false, "Enable systemd cgroup support")
+	rootCmd.PersistentFlags().String("criu", "", "Path to the criu binary used for checkpoint and restore")
+
+	carapace.Gen(rootCmd).FlagCompletion(carapace.ActionMap{
+		"root":       carapace.ActionDirectories(),
+		"log-format": carapace.ActionValues("text", "json"),
+		"criu":       os.ActionPathExecutables(),
+	})
+}
+
+// Execute executes the root command.
+func Execute() error {
+	return rootCmd.Execute()
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/runc"
+	"github.com/spf13/cobra"
+)
+
+var runCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Create and run a container",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(runCmd).Standalone()
+
+	runCmd.Flags().StringP("bundle", "b", "", "Path to the root of the bundle directory")
+	runCmd.Flags().String("console-socket", "", "Path to an AF_UNIX socket which will receive a file descriptor referencing the master end of the console's pseudoterminal")
+	runCmd.Flags().BoolP("detach", "d", false, "Detach from the container's process")
+	rootCmd.AddCommand(runCmd)
+
+	carapace.Gen(runCmd).FlagCompletion(carapace.ActionMap{
+		"bundle": runc.ActionOCIBundles(),
+	})
+}
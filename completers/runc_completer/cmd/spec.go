@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+)
+
+var specCmd = &cobra.Command{
+	Use:   "spec",
+	Short: "Create a new specification file",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(specCmd).Standalone()
+
+	specCmd.Flags().StringP("bundle", "b", "", "Path to the root of the bundle directory")
+	specCmd.Flags().Bool("rootless", false, "Generate a configuration for a rootless container")
+	rootCmd.AddCommand(specCmd)
+}
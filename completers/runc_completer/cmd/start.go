@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/runc"
+	"github.com/spf13/cobra"
+)
+
+var startCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Executes the user defined process in a created container",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(startCmd).Standalone()
+	rootCmd.AddCommand(startCmd)
+
+	carapace.Gen(startCmd).PositionalCompletion(
+		runc.ActionContainers(),
+	)
+}
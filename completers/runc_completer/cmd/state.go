@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/runc"
+	"github.com/spf13/cobra"
+)
+
+var stateCmd = &cobra.Command{
+	Use:   "state",
+	Short: "Output the state of a container",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(stateCmd).Standalone()
+	rootCmd.AddCommand(stateCmd)
+
+	carapace.Gen(stateCmd).PositionalCompletion(
+		runc.ActionContainers(),
+	)
+}
@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"github.com/rsteube/carapace"
+	"github.com/rsteube/carapace-bin/pkg/actions/runc"
+	"github.com/spf13/cobra"
+)
+
+var updateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update container resource constraints",
+	Run:   func(cmd *cobra.Command, args []string) {},
+}
+
+func init() {
+	carapace.Gen(updateCmd).Standalone()
+
+	updateCmd.Flags().String("resources", "", "Path to the file containing the resources to update or '-' to read from the standard input")
+	updateCmd.Flags().Int("pid-limit", 0, "Maximum number of pids allowed in the container")
+	rootCmd.AddCommand(updateCmd)
+
+	carapace.Gen(updateCmd).PositionalCompletion(
+		runc.ActionContainers(),
+	)
+}
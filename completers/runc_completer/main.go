@@ -0,0 +1,9 @@
+package main
+
+import (
+	"github.com/rsteube/carapace-bin/completers/runc_completer/cmd"
+)
+
+func main() {
+	cmd.Execute()
+}
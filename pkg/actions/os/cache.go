@@ -0,0 +1,38 @@
+package os
+
+import (
+	"sync"
+	"time"
+)
+
+// cacheTTL bounds how long a cached action result is reused. Actions like
+// ActionUsers or ActionPathExecutables are invoked repeatedly while the
+// user types a single word, so a short TTL avoids re-reading /etc/passwd
+// or rescanning PATH on every keystroke without risking noticeably stale
+// completions.
+const cacheTTL = 2 * time.Second
+
+type cacheEntry struct {
+	values  []string
+	expires time.Time
+}
+
+var (
+	cacheMu    sync.Mutex
+	cacheStore = make(map[string]cacheEntry)
+)
+
+// cached returns the cached result for key if it hasn't expired yet,
+// otherwise it calls fn, caches the result for cacheTTL and returns it.
+func cached(key string, fn func() []string) []string {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	if entry, ok := cacheStore[key]; ok && time.Now().Before(entry.expires) {
+		return entry.values
+	}
+
+	values := fn()
+	cacheStore[key] = cacheEntry{values: values, expires: time.Now().Add(cacheTTL)}
+	return values
+}
@@ -2,9 +2,7 @@
 package os
 
 import (
-	"io/ioutil"
 	"os"
-	"os/exec"
 	"strconv"
 	"strings"
 
@@ -13,8 +11,9 @@ import (
 )
 
 // ActionEnvironmentVariables completes environment values
-//   SHELL (/bin/elvish)
-//   LANG (en_US.utf8)
+//
+//	SHELL (/bin/elvish)
+//	LANG (en_US.utf8)
 func ActionEnvironmentVariables() carapace.Action {
 	return carapace.ActionCallback(func(args []string) carapace.Action {
 		env := os.Environ()
@@ -32,31 +31,10 @@ func ActionEnvironmentVariables() carapace.Action {
 	})
 }
 
-// ActionGroups completes system group names
-//    root (0)
-//    ssh (101)
-func ActionGroups() carapace.Action {
-	return carapace.ActionCallback(func(args []string) carapace.Action {
-		groups := []string{}
-		if content, err := ioutil.ReadFile("/etc/group"); err == nil {
-			for _, entry := range strings.Split(string(content), "\n") {
-				splitted := strings.Split(entry, ":")
-				if len(splitted) > 2 {
-					group := splitted[0]
-					id := splitted[2]
-					if len(strings.TrimSpace(group)) > 0 {
-						groups = append(groups, group, id)
-					}
-				}
-			}
-		}
-		return carapace.ActionValuesDescribed(groups...)
-	})
-}
-
 // ActionKillSignals completes linux kill signals
-//   ABRT (Abnormal termination)
-//   STOP (Stop process, unblockable)
+//
+//	ABRT (Abnormal termination)
+//	STOP (Stop process, unblockable)
 func ActionKillSignals() carapace.Action {
 	return carapace.ActionValuesDescribed(
 		"ABRT", "Abnormal termination",
@@ -94,8 +72,9 @@ func ActionKillSignals() carapace.Action {
 }
 
 // ActionProcessExecutables completes executable names of current processes
-//   NetworkManager (439)
-//   cupsd (454)
+//
+//	NetworkManager (439)
+//	cupsd (454)
 func ActionProcessExecutables() carapace.Action {
 	return carapace.ActionCallback(func(args []string) carapace.Action {
 		if processes, err := ps.Processes(); err != nil {
@@ -111,8 +90,9 @@ func ActionProcessExecutables() carapace.Action {
 }
 
 // ActionProcessStates completes linux process states
-//   I (Idle kernel thread)
-//   R (running or runnable on run queue)
+//
+//	I (Idle kernel thread)
+//	R (running or runnable on run queue)
 func ActionProcessStates() carapace.Action {
 	return carapace.ActionValuesDescribed(
 		"D", "uninterruptible sleep (usually IO)",
@@ -127,31 +107,10 @@ func ActionProcessStates() carapace.Action {
 	)
 }
 
-// ActionUsers completes system user names
-//   root (0)
-//   daemon (1)
-func ActionUsers() carapace.Action {
-	return carapace.ActionCallback(func(args []string) carapace.Action {
-		users := []string{}
-		if content, err := ioutil.ReadFile("/etc/passwd"); err == nil {
-			for _, entry := range strings.Split(string(content), "\n") {
-				splitted := strings.Split(entry, ":")
-				if len(splitted) > 2 {
-					user := splitted[0]
-					id := splitted[2]
-					if len(strings.TrimSpace(user)) > 0 {
-						users = append(users, user, id)
-					}
-				}
-			}
-		}
-		return carapace.ActionValuesDescribed(users...)
-	})
-}
-
 // ActionUserGroup completes system user:group separately
-//   bin:audio
-//   lp:list
+//
+//	bin:audio
+//	lp:list
 func ActionUserGroup() carapace.Action {
 	return carapace.ActionMultiParts(":", func(args []string, parts []string) carapace.Action {
 		switch len(parts) {
@@ -165,44 +124,6 @@ func ActionUserGroup() carapace.Action {
 	})
 }
 
-// ActionShells completes available terminal shells
-//   /bin/elvish
-//   /bin/bash
-func ActionShells() carapace.Action {
-	return carapace.ActionCallback(func(args []string) carapace.Action {
-		if output, err := exec.Command("chsh", "--list-shells").Output(); err != nil {
-			return carapace.ActionMessage(err.Error())
-		} else {
-			return carapace.ActionValues(strings.Split(string(output), "\n")...)
-		}
-	})
-}
-
-// ActionPathExecutables completes executable files from PATH
-//   nvim
-//   chmod
-func ActionPathExecutables() carapace.Action {
-	return carapace.ActionCallback(func(args []string) carapace.Action {
-		executables := make(map[string]bool)
-
-		for _, folder := range strings.Split(os.Getenv("PATH"), ":") {
-			if files, err := ioutil.ReadDir(folder); err == nil {
-				for _, f := range files {
-					if f.Mode().IsRegular() && isExecAny(f.Mode()) {
-						executables[f.Name()] = true
-					}
-				}
-			}
-		}
-
-		vals := make([]string, 0)
-		for executable := range executables {
-			vals = append(vals, executable)
-		}
-		return carapace.ActionValues(vals...)
-	})
-}
-
 func isExecAny(mode os.FileMode) bool {
 	return mode&0111 != 0
-}
\ No newline at end of file
+}
@@ -0,0 +1,112 @@
+//go:build darwin
+
+package os
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/rsteube/carapace"
+)
+
+// ActionGroups completes system group names
+//
+//	staff (20)
+//	admin (80)
+func ActionGroups() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		return carapace.ActionValuesDescribed(cached("groups", func() []string {
+			return dsclEntries("/Groups")
+		})...)
+	})
+}
+
+// ActionUsers completes system user names
+//
+//	root (0)
+//	daemon (1)
+func ActionUsers() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		return carapace.ActionValuesDescribed(cached("users", func() []string {
+			return dsclEntries("/Users")
+		})...)
+	})
+}
+
+// dsclEntries lists the names below a dscl directory service path together
+// with their UniqueID/PrimaryGroupID, e.g. `dscl . -list /Users UniqueID`.
+func dsclEntries(path string) []string {
+	attribute := "UniqueID"
+	if path == "/Groups" {
+		attribute = "PrimaryGroupID"
+	}
+	output, err := exec.Command("dscl", ".", "-list", path, attribute).Output()
+	if err != nil {
+		return []string{}
+	}
+
+	entries := []string{}
+	for _, line := range strings.Split(string(output), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 {
+			entries = append(entries, fields[0], fields[1])
+		}
+	}
+	return entries
+}
+
+// ActionShells completes available terminal shells
+//
+//	/bin/zsh
+//	/bin/bash
+func ActionShells() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		return carapace.ActionValues(cached("shells", func() []string {
+			if output, err := exec.Command("chsh", "--list-shells").Output(); err == nil {
+				return strings.Split(string(output), "\n")
+			}
+
+			if content, err := ioutil.ReadFile("/etc/shells"); err == nil {
+				shells := make([]string, 0)
+				for _, line := range strings.Split(string(content), "\n") {
+					line = strings.TrimSpace(line)
+					if line != "" && !strings.HasPrefix(line, "#") {
+						shells = append(shells, line)
+					}
+				}
+				return shells
+			}
+			return []string{}
+		})...)
+	})
+}
+
+// ActionPathExecutables completes executable files from PATH
+//
+//	nvim
+//	chmod
+func ActionPathExecutables() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		return carapace.ActionValues(cached("pathExecutables", func() []string {
+			executables := make(map[string]bool)
+
+			for _, folder := range strings.Split(os.Getenv("PATH"), ":") {
+				if files, err := ioutil.ReadDir(folder); err == nil {
+					for _, f := range files {
+						if f.Mode().IsRegular() && isExecAny(f.Mode()) {
+							executables[f.Name()] = true
+						}
+					}
+				}
+			}
+
+			vals := make([]string, 0, len(executables))
+			for executable := range executables {
+				vals = append(vals, executable)
+			}
+			return vals
+		})...)
+	})
+}
@@ -0,0 +1,130 @@
+//go:build linux
+
+package os
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/rsteube/carapace"
+)
+
+// ActionLinuxCapabilities completes linux capabilities, by their bare name
+// as accepted by `podman run --cap-add`/`--cap-drop`
+//
+//	CHOWN (CAP_CHOWN: Make arbitrary changes to file UIDs and GIDs)
+//	NET_ADMIN (CAP_NET_ADMIN: Perform various network-related operations)
+func ActionLinuxCapabilities() carapace.Action {
+	return carapace.ActionValuesDescribed(
+		"AUDIT_CONTROL", "CAP_AUDIT_CONTROL: Enable and disable kernel auditing; change auditing filter rules",
+		"AUDIT_READ", "CAP_AUDIT_READ: Allow reading the audit log via multicast netlink socket",
+		"AUDIT_WRITE", "CAP_AUDIT_WRITE: Write records to kernel auditing log",
+		"BLOCK_SUSPEND", "CAP_BLOCK_SUSPEND: Employ features that can block system suspend",
+		"BPF", "CAP_BPF: Employ privileged BPF operations",
+		"CHECKPOINT_RESTORE", "CAP_CHECKPOINT_RESTORE: Employ various checkpoint/restore related operations",
+		"CHOWN", "CAP_CHOWN: Make arbitrary changes to file UIDs and GIDs",
+		"DAC_OVERRIDE", "CAP_DAC_OVERRIDE: Bypass file read, write, and execute permission checks",
+		"DAC_READ_SEARCH", "CAP_DAC_READ_SEARCH: Bypass file read permission checks and directory read/execute checks",
+		"FOWNER", "CAP_FOWNER: Bypass permission checks on operations that normally require the file owner's UID",
+		"FSETID", "CAP_FSETID: Don't clear set-user-ID and set-group-ID permission bits when a file is modified",
+		"IPC_LOCK", "CAP_IPC_LOCK: Lock memory (mlock, mlockall, mmap, shmctl)",
+		"IPC_OWNER", "CAP_IPC_OWNER: Bypass permission checks for operations on System V IPC objects",
+		"KILL", "CAP_KILL: Bypass permission checks for sending signals",
+		"LEASE", "CAP_LEASE: Establish leases on arbitrary files",
+		"LINUX_IMMUTABLE", "CAP_LINUX_IMMUTABLE: Set the FS_APPEND_FL and FS_IMMUTABLE_FL inode flags",
+		"MAC_ADMIN", "CAP_MAC_ADMIN: Allow MAC configuration or state changes",
+		"MAC_OVERRIDE", "CAP_MAC_OVERRIDE: Override Mandatory Access Control",
+		"MKNOD", "CAP_MKNOD: Create special files using mknod",
+		"NET_ADMIN", "CAP_NET_ADMIN: Perform various network-related operations",
+		"NET_BIND_SERVICE", "CAP_NET_BIND_SERVICE: Bind a socket to privileged ports (port numbers less than 1024)",
+		"NET_BROADCAST", "CAP_NET_BROADCAST: Make socket broadcasts and listen to multicast",
+		"NET_RAW", "CAP_NET_RAW: Use RAW and PACKET sockets; bind to any address for transparent proxying",
+		"PERFMON", "CAP_PERFMON: Employ various performance-monitoring mechanisms",
+		"SETGID", "CAP_SETGID: Make arbitrary manipulations of process GIDs and supplementary GID list",
+		"SETFCAP", "CAP_SETFCAP: Set file capabilities",
+		"SETPCAP", "CAP_SETPCAP: Add any capability to its own permitted set",
+		"SETUID", "CAP_SETUID: Make arbitrary manipulations of process UIDs",
+		"SYS_ADMIN", "CAP_SYS_ADMIN: Perform a range of system administration operations",
+		"SYS_BOOT", "CAP_SYS_BOOT: Use reboot and kexec_load",
+		"SYS_CHROOT", "CAP_SYS_CHROOT: Use chroot; change mount namespace using setns",
+		"SYS_MODULE", "CAP_SYS_MODULE: Load and unload kernel modules",
+		"SYS_NICE", "CAP_SYS_NICE: Raise process nice value and change the nice value for arbitrary processes",
+		"SYS_PACCT", "CAP_SYS_PACCT: Use acct",
+		"SYS_PTRACE", "CAP_SYS_PTRACE: Trace arbitrary processes using ptrace",
+		"SYS_RAWIO", "CAP_SYS_RAWIO: Perform I/O port operations",
+		"SYS_RESOURCE", "CAP_SYS_RESOURCE: Override resource limits",
+		"SYS_TIME", "CAP_SYS_TIME: Set system clock; set real-time (hardware) clock",
+		"SYS_TTY_CONFIG", "CAP_SYS_TTY_CONFIG: Use vhangup; employ various privileged ioctl operations on virtual terminals",
+		"SYSLOG", "CAP_SYSLOG: Perform privileged syslog operations",
+		"WAKE_ALARM", "CAP_WAKE_ALARM: Trigger something that will wake up the system",
+	)
+}
+
+// ActionCgroupControllers completes the cgroup controllers available on the
+// current system, preferring the unified (v2) controller list and falling
+// back to the per-controller directories under /sys/fs/cgroup on v1.
+//
+//	cpu
+//	memory
+func ActionCgroupControllers() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		if content, err := ioutil.ReadFile("/sys/fs/cgroup/cgroup.controllers"); err == nil {
+			return carapace.ActionValues(strings.Fields(string(content))...)
+		}
+
+		entries, err := ioutil.ReadDir("/sys/fs/cgroup")
+		if err != nil {
+			return carapace.ActionMessage(err.Error())
+		}
+
+		vals := make([]string, 0)
+		for _, entry := range entries {
+			if entry.IsDir() {
+				vals = append(vals, entry.Name())
+			}
+		}
+		return carapace.ActionValues(vals...)
+	})
+}
+
+// ActionSeccompProfiles completes seccomp profile files shipped by
+// container runtimes, e.g. `/usr/share/containers/seccomp.json`.
+//
+//	/usr/share/containers/seccomp.json
+func ActionSeccompProfiles() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		vals := make([]string, 0)
+		for _, pattern := range []string{
+			"/usr/share/containers/seccomp*.json",
+			"/etc/containers/seccomp*.json",
+		} {
+			if matches, err := filepath.Glob(pattern); err == nil {
+				vals = append(vals, matches...)
+			}
+		}
+		return carapace.ActionValues(vals...)
+	})
+}
+
+// ActionAppArmorProfiles completes the AppArmor profiles loaded into the
+// kernel
+//
+//	docker-default
+//	unconfined
+func ActionAppArmorProfiles() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		content, err := ioutil.ReadFile("/sys/kernel/security/apparmor/profiles")
+		if err != nil {
+			return carapace.ActionMessage(err.Error())
+		}
+
+		vals := make([]string, 0)
+		for _, line := range strings.Split(string(content), "\n") {
+			if fields := strings.Fields(line); len(fields) > 0 {
+				vals = append(vals, fields[0])
+			}
+		}
+		return carapace.ActionValues(vals...)
+	})
+}
@@ -0,0 +1,106 @@
+//go:build !windows && !darwin
+
+package os
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/rsteube/carapace"
+)
+
+// ActionGroups completes system group names
+//
+//	root (0)
+//	ssh (101)
+func ActionGroups() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		return carapace.ActionValuesDescribed(cached("groups", func() []string {
+			groups := []string{}
+			if content, err := ioutil.ReadFile("/etc/group"); err == nil {
+				for _, entry := range strings.Split(string(content), "\n") {
+					splitted := strings.Split(entry, ":")
+					if len(splitted) > 2 {
+						group := splitted[0]
+						id := splitted[2]
+						if len(strings.TrimSpace(group)) > 0 {
+							groups = append(groups, group, id)
+						}
+					}
+				}
+			}
+			return groups
+		})...)
+	})
+}
+
+// ActionUsers completes system user names
+//
+//	root (0)
+//	daemon (1)
+func ActionUsers() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		return carapace.ActionValuesDescribed(cached("users", func() []string {
+			users := []string{}
+			if content, err := ioutil.ReadFile("/etc/passwd"); err == nil {
+				for _, entry := range strings.Split(string(content), "\n") {
+					splitted := strings.Split(entry, ":")
+					if len(splitted) > 2 {
+						user := splitted[0]
+						id := splitted[2]
+						if len(strings.TrimSpace(user)) > 0 {
+							users = append(users, user, id)
+						}
+					}
+				}
+			}
+			return users
+		})...)
+	})
+}
+
+// ActionShells completes available terminal shells
+//
+//	/bin/elvish
+//	/bin/bash
+func ActionShells() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		return carapace.ActionValues(cached("shells", func() []string {
+			output, err := exec.Command("chsh", "--list-shells").Output()
+			if err != nil {
+				return []string{}
+			}
+			return strings.Split(string(output), "\n")
+		})...)
+	})
+}
+
+// ActionPathExecutables completes executable files from PATH
+//
+//	nvim
+//	chmod
+func ActionPathExecutables() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		return carapace.ActionValues(cached("pathExecutables", func() []string {
+			executables := make(map[string]bool)
+
+			for _, folder := range strings.Split(os.Getenv("PATH"), ":") {
+				if files, err := ioutil.ReadDir(folder); err == nil {
+					for _, f := range files {
+						if f.Mode().IsRegular() && isExecAny(f.Mode()) {
+							executables[f.Name()] = true
+						}
+					}
+				}
+			}
+
+			vals := make([]string, 0, len(executables))
+			for executable := range executables {
+				vals = append(vals, executable)
+			}
+			return vals
+		})...)
+	})
+}
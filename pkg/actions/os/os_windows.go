@@ -0,0 +1,133 @@
+//go:build windows
+
+package os
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"golang.org/x/sys/windows/registry"
+
+	"github.com/rsteube/carapace"
+)
+
+// ActionGroups completes local group names
+//
+//	Administrators
+//	Users
+func ActionGroups() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		return carapace.ActionValues(cached("groups", func() []string {
+			output, err := exec.Command("net", "localgroup").Output()
+			if err != nil {
+				return []string{}
+			}
+
+			groups := make([]string, 0)
+			for _, line := range strings.Split(string(output), "\n") {
+				line = strings.TrimSpace(line)
+				if strings.HasPrefix(line, "*") {
+					groups = append(groups, strings.TrimPrefix(line, "*"))
+				}
+			}
+			return groups
+		})...)
+	})
+}
+
+// ActionUsers completes local user account names
+//
+//	Administrator
+//	Guest
+func ActionUsers() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		return carapace.ActionValues(cached("users", func() []string {
+			output, err := exec.Command("net", "user").Output()
+			if err != nil {
+				return []string{}
+			}
+
+			users := make([]string, 0)
+			inTable := false
+			for _, line := range strings.Split(string(output), "\n") {
+				line = strings.TrimRight(line, "\r")
+				switch {
+				case strings.HasPrefix(line, "---"):
+					inTable = true
+				case inTable && strings.TrimSpace(line) == "":
+					inTable = false
+				case inTable:
+					users = append(users, strings.Fields(line)...)
+				}
+			}
+			return users
+		})...)
+	})
+}
+
+// ActionShells completes available command interpreters, looking at the
+// registered system shell as well as any PowerShell/pwsh installation.
+//
+//	C:\Windows\System32\cmd.exe
+//	C:\Program Files\PowerShell\7\pwsh.exe
+func ActionShells() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		return carapace.ActionValues(cached("shells", func() []string {
+			shells := make([]string, 0)
+
+			if key, err := registry.OpenKey(registry.LOCAL_MACHINE, `SOFTWARE\Microsoft\Command Processor`, registry.QUERY_VALUE); err == nil {
+				defer key.Close()
+				if autorun, _, err := key.GetStringValue("Autorun"); err == nil && autorun != "" {
+					shells = append(shells, autorun)
+				}
+			}
+			shells = append(shells, `C:\Windows\System32\cmd.exe`, `C:\Windows\System32\WindowsPowerShell\v1.0\powershell.exe`)
+
+			if path, err := exec.LookPath("pwsh.exe"); err == nil {
+				shells = append(shells, path)
+			}
+			return shells
+		})...)
+	})
+}
+
+// ActionPathExecutables completes executable files from PATH, filtered by
+// the extensions listed in %PATHEXT%.
+//
+//	notepad.exe
+//	choco.exe
+func ActionPathExecutables() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		return carapace.ActionValues(cached("pathExecutables", func() []string {
+			pathext := strings.Split(strings.ToLower(os.Getenv("PATHEXT")), ";")
+
+			executables := make(map[string]bool)
+			for _, folder := range strings.Split(os.Getenv("PATH"), ";") {
+				files, err := ioutil.ReadDir(folder)
+				if err != nil {
+					continue
+				}
+				for _, f := range files {
+					if f.IsDir() {
+						continue
+					}
+					name := strings.ToLower(f.Name())
+					for _, ext := range pathext {
+						if ext != "" && strings.HasSuffix(name, ext) {
+							executables[f.Name()] = true
+							break
+						}
+					}
+				}
+			}
+
+			vals := make([]string, 0, len(executables))
+			for executable := range executables {
+				vals = append(vals, executable)
+			}
+			return vals
+		})...)
+	})
+}
@@ -0,0 +1,301 @@
+// package podman contains actions related to the podman command
+package podman
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/rsteube/carapace"
+)
+
+// connectionArgs prefixes a podman invocation with the connection/remote
+// flags implied by `--connection` or `CONTAINER_HOST`, mirroring how the
+// docker actions respect `DOCKER_HOST`.
+func connectionArgs(args []string) []string {
+	for index, arg := range args {
+		if arg == "--connection" && index+1 < len(args) {
+			return []string{"--connection", args[index+1]}
+		}
+		if strings.HasPrefix(arg, "--connection=") {
+			return []string{"--connection", strings.TrimPrefix(arg, "--connection=")}
+		}
+	}
+	if host := os.Getenv("CONTAINER_HOST"); host != "" {
+		return []string{"--url", host}
+	}
+	return nil
+}
+
+// execute runs `podman <args>` (prefixed with any connection args) and
+// returns its stdout.
+func execute(args []string, podmanArgs ...string) ([]byte, error) {
+	cmd := exec.Command("podman", append(connectionArgs(args), podmanArgs...)...)
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	err := cmd.Run()
+	return stdout.Bytes(), err
+}
+
+// ActionContainers completes podman container names
+//
+//	pensive_torvalds (running)
+//	agitated_wozniak (exited)
+func ActionContainers() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		output, err := execute(args, "container", "ls", "--all", "--format", "{{json .}}")
+		if err != nil {
+			return carapace.ActionMessage(err.Error())
+		}
+
+		vals := make([]string, 0)
+		scanner := bufio.NewScanner(bytes.NewReader(output))
+		for scanner.Scan() {
+			var c struct {
+				Names []string `json:"Names"`
+				State string   `json:"State"`
+			}
+			if err := json.Unmarshal(scanner.Bytes(), &c); err == nil {
+				for _, name := range c.Names {
+					vals = append(vals, name, c.State)
+				}
+			}
+		}
+		return carapace.ActionValuesDescribed(vals...)
+	})
+}
+
+// ActionPods completes podman pod names
+//
+//	friendly_hopper (Running)
+func ActionPods() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		output, err := execute(args, "pod", "ps", "--format", "{{json .}}")
+		if err != nil {
+			return carapace.ActionMessage(err.Error())
+		}
+
+		vals := make([]string, 0)
+		scanner := bufio.NewScanner(bytes.NewReader(output))
+		for scanner.Scan() {
+			var p struct {
+				Name   string `json:"Name"`
+				Status string `json:"Status"`
+			}
+			if err := json.Unmarshal(scanner.Bytes(), &p); err == nil {
+				vals = append(vals, p.Name, p.Status)
+			}
+		}
+		return carapace.ActionValuesDescribed(vals...)
+	})
+}
+
+// ActionImages completes podman image names
+//
+//	docker.io/library/alpine:latest (12.3MB)
+func ActionImages() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		output, err := execute(args, "image", "ls", "--format", "{{json .}}")
+		if err != nil {
+			return carapace.ActionMessage(err.Error())
+		}
+
+		vals := make([]string, 0)
+		scanner := bufio.NewScanner(bytes.NewReader(output))
+		for scanner.Scan() {
+			var i struct {
+				Names []string `json:"Names"`
+				Size  string   `json:"Size"`
+			}
+			if err := json.Unmarshal(scanner.Bytes(), &i); err == nil {
+				for _, name := range i.Names {
+					vals = append(vals, name, i.Size)
+				}
+			}
+		}
+		return carapace.ActionValuesDescribed(vals...)
+	})
+}
+
+// ActionVolumes completes podman volume names
+//
+//	my-data (local)
+func ActionVolumes() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		output, err := execute(args, "volume", "ls", "--format", "{{json .}}")
+		if err != nil {
+			return carapace.ActionMessage(err.Error())
+		}
+
+		vals := make([]string, 0)
+		scanner := bufio.NewScanner(bytes.NewReader(output))
+		for scanner.Scan() {
+			var v struct {
+				Name   string `json:"Name"`
+				Driver string `json:"Driver"`
+			}
+			if err := json.Unmarshal(scanner.Bytes(), &v); err == nil {
+				vals = append(vals, v.Name, v.Driver)
+			}
+		}
+		return carapace.ActionValuesDescribed(vals...)
+	})
+}
+
+// ActionNetworks completes podman network names
+//
+//	podman (bridge)
+func ActionNetworks() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		output, err := execute(args, "network", "ls", "--format", "{{json .}}")
+		if err != nil {
+			return carapace.ActionMessage(err.Error())
+		}
+
+		vals := make([]string, 0)
+		scanner := bufio.NewScanner(bytes.NewReader(output))
+		for scanner.Scan() {
+			var n struct {
+				Name   string `json:"Name"`
+				Driver string `json:"Driver"`
+			}
+			if err := json.Unmarshal(scanner.Bytes(), &n); err == nil {
+				vals = append(vals, n.Name, n.Driver)
+			}
+		}
+		return carapace.ActionValuesDescribed(vals...)
+	})
+}
+
+// ActionSecrets completes podman secret names
+//
+//	db-password (file)
+func ActionSecrets() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		output, err := execute(args, "secret", "ls", "--format", "{{json .}}")
+		if err != nil {
+			return carapace.ActionMessage(err.Error())
+		}
+
+		vals := make([]string, 0)
+		scanner := bufio.NewScanner(bytes.NewReader(output))
+		for scanner.Scan() {
+			var s struct {
+				Name   string `json:"Name"`
+				Driver string `json:"Driver"`
+			}
+			if err := json.Unmarshal(scanner.Bytes(), &s); err == nil {
+				vals = append(vals, s.Name, s.Driver)
+			}
+		}
+		return carapace.ActionValuesDescribed(vals...)
+	})
+}
+
+// ActionMachines completes podman machine names (rootless VMs on macOS/Windows)
+//
+//	podman-machine-default (Running)
+func ActionMachines() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		output, err := execute(args, "machine", "list", "--format", "json")
+		if err != nil {
+			return carapace.ActionMessage(err.Error())
+		}
+
+		var machines []struct {
+			Name    string `json:"Name"`
+			Running bool   `json:"Running"`
+		}
+		if err := json.Unmarshal(output, &machines); err != nil {
+			return carapace.ActionMessage(err.Error())
+		}
+
+		vals := make([]string, 0, len(machines)*2)
+		for _, m := range machines {
+			state := "Stopped"
+			if m.Running {
+				state = "Running"
+			}
+			vals = append(vals, strings.TrimSuffix(m.Name, "*"), state)
+		}
+		return carapace.ActionValuesDescribed(vals...)
+	})
+}
+
+// ActionConnections completes names configured with `podman system connection add`
+//
+//	production (ssh://user@example.com/run/podman/podman.sock)
+func ActionConnections() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		output, err := execute(args, "system", "connection", "list", "--format", "{{json .}}")
+		if err != nil {
+			return carapace.ActionMessage(err.Error())
+		}
+
+		vals := make([]string, 0)
+		scanner := bufio.NewScanner(bytes.NewReader(output))
+		for scanner.Scan() {
+			var c struct {
+				Name        string `json:"Name"`
+				Destination string `json:"Destination"`
+			}
+			if err := json.Unmarshal(scanner.Bytes(), &c); err == nil {
+				vals = append(vals, c.Name, c.Destination)
+			}
+		}
+		return carapace.ActionValuesDescribed(vals...)
+	})
+}
+
+// ActionKubeYamls completes yaml files for `podman generate kube`/`podman play kube`
+//
+//	pod.yaml
+func ActionKubeYamls() carapace.Action {
+	return carapace.ActionFiles(".yaml", ".yml")
+}
+
+// ActionNamespaceMode completes the mode strings accepted by podman's
+// `--cgroupns`/`--ipc`/`--pid`/`--uts` flags.
+//
+//	host
+//	private
+func ActionNamespaceMode() carapace.Action {
+	return namespaceModeAction("host", "private")
+}
+
+// ActionUserNamespaceMode completes the mode strings accepted by podman's
+// `--userns` flag, which additionally allows `auto`, `keep-id` and `nomap`.
+//
+//	host
+//	keep-id
+func ActionUserNamespaceMode() carapace.Action {
+	return namespaceModeAction("host", "private", "auto", "keep-id", "nomap")
+}
+
+// namespaceModeAction completes modes, plus the `container:<name>` and
+// `ns:<path>` forms every namespace flag also accepts.
+func namespaceModeAction(modes ...string) carapace.Action {
+	return carapace.ActionMultiParts(":", func(args []string, parts []string) carapace.Action {
+		switch len(parts) {
+		case 0:
+			return carapace.Batch(
+				carapace.ActionValues(modes...),
+				carapace.ActionValues("container", "ns").Suffix(":").ToA(),
+			).ToA()
+		case 1:
+			switch parts[0] {
+			case "container":
+				return ActionContainers()
+			case "ns":
+				return carapace.ActionFiles()
+			default:
+				return carapace.ActionValues()
+			}
+		default:
+			return carapace.ActionValues()
+		}
+	})
+}
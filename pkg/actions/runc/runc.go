@@ -0,0 +1,86 @@
+// package runc contains actions related to the runc command
+package runc
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/rsteube/carapace"
+)
+
+// ActionContainers completes runc container ids
+//
+//	busybox (running)
+//	alpine (stopped)
+func ActionContainers() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		output, err := exec.Command("runc", "list", "--format", "json").Output()
+		if err != nil {
+			return carapace.ActionMessage(err.Error())
+		}
+
+		var containers []struct {
+			ID     string `json:"id"`
+			Status string `json:"status"`
+		}
+		if err := json.Unmarshal(output, &containers); err != nil {
+			return carapace.ActionMessage(err.Error())
+		}
+
+		vals := make([]string, 0, len(containers)*2)
+		for _, c := range containers {
+			vals = append(vals, c.ID, c.Status)
+		}
+		return carapace.ActionValuesDescribed(vals...)
+	})
+}
+
+// ActionCheckpoints completes checkpoint names found in the image path
+// passed to `runc checkpoint`/`runc restore --image-path`
+//
+//	checkpoint1
+func ActionCheckpoints() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		imagePath := "checkpoint"
+		for index, arg := range args {
+			if arg == "--image-path" && index+1 < len(args) {
+				imagePath = args[index+1]
+			}
+		}
+
+		entries, err := ioutil.ReadDir(imagePath)
+		if err != nil {
+			return carapace.ActionMessage(err.Error())
+		}
+
+		vals := make([]string, 0)
+		for _, entry := range entries {
+			if entry.IsDir() {
+				vals = append(vals, entry.Name())
+			}
+		}
+		return carapace.ActionValues(vals...)
+	})
+}
+
+// ActionOCIBundles completes directories containing an OCI `config.json`
+//
+//	/var/lib/containers/bundle
+func ActionOCIBundles() carapace.Action {
+	return carapace.ActionCallback(func(args []string) carapace.Action {
+		return carapace.ActionFiles().Invoke(args).Filter(func(values []string) []string {
+			filtered := make([]string, 0, len(values))
+			for _, value := range values {
+				if info, err := os.Stat(value); err == nil && info.IsDir() {
+					if _, err := os.Stat(filepath.Join(value, "config.json")); err == nil {
+						filtered = append(filtered, value)
+					}
+				}
+			}
+			return filtered
+		}).ToA()
+	})
+}
@@ -0,0 +1,22 @@
+package schemas
+
+// DockerServiceLogEntry mirrors the struct `docker service logs` formats a
+// log line against.
+type DockerServiceLogEntry struct {
+	ID        string
+	Timestamp string
+	Node      struct {
+		ID       string
+		Hostname string
+	}
+	Service struct {
+		ID   string
+		Name string
+	}
+	Task struct {
+		ID   string
+		Name string
+		Slot int
+	}
+	Data string
+}
@@ -0,0 +1,26 @@
+package schemas
+
+// DockerVersion mirrors the struct `docker version --format` is evaluated
+// against (a trimmed copy of docker/cli's types.Version).
+type DockerVersion struct {
+	Client struct {
+		Version    string
+		ApiVersion string
+		GoVersion  string
+		GitCommit  string
+		Os         string
+		Arch       string
+	}
+	Server struct {
+		Version    string
+		ApiVersion string
+		GoVersion  string
+		GitCommit  string
+		Os         string
+		Arch       string
+		Components []struct {
+			Name    string
+			Version string
+		}
+	}
+}
@@ -0,0 +1,7 @@
+package schemas
+
+// MinikubeCacheListImage mirrors the struct `minikube cache list --format`
+// is evaluated against.
+type MinikubeCacheListImage struct {
+	CacheImage string
+}
@@ -0,0 +1,128 @@
+// package tmpl completes fields of Go text/template expressions evaluated
+// against a known struct, e.g. the `--format` flag of `docker version` or
+// `cache list`.
+package tmpl
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/rsteube/carapace"
+)
+
+// builtinNames are the template actions/functions offered alongside struct
+// fields right after `{{`.
+var builtinNames = []string{
+	"if", "conditionally execute a block",
+	"range", "iterate over an array, slice, map or channel",
+	"with", "execute a block if the value is non-empty",
+	"end", "close an if/range/with block",
+	"json", "marshal the value as json",
+	"println", "print the value followed by a newline",
+	"table", "render the value as a table",
+}
+
+// ActionGoTemplate completes a Go template expression evaluated against an
+// instance of schema, e.g. given
+//
+//	type Version struct {
+//	    Client struct{ Version string }
+//	}
+//
+// typing `{{.Client.` completes `Version`.
+func ActionGoTemplate(schema interface{}) carapace.Action {
+	t := reflect.TypeOf(schema)
+
+	return carapace.ActionMultiParts(".", func(args []string, parts []string) carapace.Action {
+		// No "." has been typed yet, so the word being completed (e.g. "",
+		// "{{" or "{{js") is matched against our values as a whole -
+		// unlike every segment after the first, there is no confirmed
+		// prefix for carapace to prepend for us. Offer full `{{...`
+		// candidates so a bare `{{` still matches.
+		if len(parts) == 0 {
+			vals := make([]string, 0, len(builtinNames))
+			for i := 0; i+1 < len(builtinNames); i += 2 {
+				vals = append(vals, "{{"+builtinNames[i], builtinNames[i+1])
+			}
+			for _, field := range exportedFields(t) {
+				vals = append(vals, "{{."+field.Name, field.Type.String())
+			}
+			return carapace.ActionValuesDescribed(vals...)
+		}
+
+		if !strings.HasPrefix(parts[0], "{{") {
+			return carapace.ActionValues()
+		}
+
+		segments := parts[1:]
+		if len(segments) == 0 {
+			return carapace.Batch(fieldsOf(t), carapace.ActionValuesDescribed(builtinNames...)).ToA()
+		}
+		return fieldsAt(t, strings.Join(segments, "."))
+	})
+}
+
+// fieldsAt walks the dotted path (already confirmed path segments joined
+// back together) through t, following exported struct fields, and returns
+// the completions reachable from the context type at the end of the path.
+func fieldsAt(t reflect.Type, path string) carapace.Action {
+	t = deref(t)
+
+	segment, rest, hasRest := cut(path, ".")
+	if t.Kind() != reflect.Struct {
+		return carapace.ActionValues()
+	}
+
+	field, ok := t.FieldByName(segment)
+	if !ok {
+		return carapace.ActionValues()
+	}
+
+	if !hasRest {
+		return fieldsOf(field.Type)
+	}
+	return fieldsAt(field.Type, rest)
+}
+
+// fieldsOf completes the exported field names of t, described by their Go
+// type.
+func fieldsOf(t reflect.Type) carapace.Action {
+	fields := exportedFields(t)
+
+	vals := make([]string, 0, len(fields)*2)
+	for _, field := range fields {
+		vals = append(vals, field.Name, field.Type.String())
+	}
+	return carapace.ActionValuesDescribed(vals...)
+}
+
+// exportedFields returns the exported struct fields of t, or nil if t isn't
+// a struct.
+func exportedFields(t reflect.Type) []reflect.StructField {
+	t = deref(t)
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	fields := make([]reflect.StructField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.PkgPath == "" { // exported
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func deref(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+func cut(s string, sep string) (before string, after string, found bool) {
+	if index := strings.Index(s, sep); index >= 0 {
+		return s[:index], s[index+len(sep):], true
+	}
+	return s, "", false
+}